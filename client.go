@@ -11,6 +11,7 @@ package gosseract
 import "C"
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"unsafe"
@@ -28,14 +29,27 @@ func Version() string {
 type Client struct {
 	api C.TessBaseAPI
 
+	// pix is a Leptonica Pix set via SetPix, or decoded from SetImageFromBytes/SetImageFromReader.
+	// When ownsPix is true, it was created by this Client and is freed in Close().
+	pix     unsafe.Pointer
+	ownsPix bool
+
+	// imageBytes holds in-memory image data set via SetImageFromBytes/SetImageFromReader,
+	// decoded into `pix` lazily in `prepare`.
+	imageBytes []byte
+
+	// rect, if set via SetRectangle, restricts OCR to a sub-region of the image.
+	rect *rectangle
+
 	// Trim specifies characters to trim, which would be trimed from result string.
 	// As results of OCR, text often contains unnecessary characters, such as newlines, on the head/foot of string.
 	// If `Trim` is set, this client will remove specified characters from the result.
 	Trim bool
 
 	// TessdataPrefix can indicate directory path to `tessdata`.
-	// It is set `/usr/local/share/tessdata/` or something like that, as default.
-	// TODO: Implement and test
+	// If not set, the TESSDATA_PREFIX environment variable is used; if that is
+	// unset too, Tesseract falls back to its compiled-in default, typically
+	// `/usr/local/share/tessdata/`.
 	TessdataPrefix *string
 
 	// Languages are languages to be detected. If not specified, it's gonna be "eng".
@@ -75,6 +89,7 @@ func (c *Client) Close() (err error) {
 	// 		err = fmt.Errorf("%v", e)
 	// 	}
 	// }()
+	c.freePix()
 	C.Free(c.api)
 	return err
 }
@@ -82,9 +97,54 @@ func (c *Client) Close() (err error) {
 // SetImage sets path to image file to be processed OCR.
 func (c *Client) SetImage(imagepath string) *Client {
 	c.ImagePath = imagepath
+	c.imageBytes = nil
+	c.freePix()
 	return c
 }
 
+// SetImageFromBytes loads image data held in memory, such as an HTTP upload or
+// the output of an image processing pipeline, instead of a path on disk.
+// The bytes are decoded via Leptonica's pixReadMem the next time OCR runs.
+func (c *Client) SetImageFromBytes(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("gosseract: image data must not be empty")
+	}
+	c.ImagePath = ""
+	c.freePix()
+	c.imageBytes = data
+	return nil
+}
+
+// SetImageFromReader reads all of r and behaves like SetImageFromBytes.
+func (c *Client) SetImageFromReader(r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("gosseract: failed to read image: %w", err)
+	}
+	return c.SetImageFromBytes(data)
+}
+
+// SetPix sets a Leptonica Pix that the caller already holds as OCR input.
+// Ownership of pix stays with the caller: unlike SetImageFromBytes/SetImageFromReader,
+// it is not freed by Close().
+func (c *Client) SetPix(pix unsafe.Pointer) *Client {
+	c.ImagePath = ""
+	c.imageBytes = nil
+	c.freePix()
+	c.pix = pix
+	c.ownsPix = false
+	return c
+}
+
+// freePix releases `pix` if this Client created it, and clears it either way.
+func (c *Client) freePix() {
+	if c.pix != nil && c.ownsPix {
+		C.DestroyPix(c.pix)
+	}
+	c.pix = nil
+	c.ownsPix = false
+}
+
 // SetLanguage sets languages to use. English as default.
 func (c *Client) SetLanguage(langs ...string) *Client {
 	c.Languages = langs
@@ -111,6 +171,21 @@ func (c *Client) SetPageSegMode(mode PageSegMode) *Client {
 	return c
 }
 
+// rectangle is the sub-region of an image to OCR, set via SetRectangle.
+type rectangle struct {
+	X, Y, W, H int
+}
+
+// SetRectangle restricts OCR to the sub-region (x, y)-(x+w, y+h) of the loaded
+// image, wrapping tesseract::TessBaseAPI::SetRectangle. It can be called again
+// between calls to Text()/HTML()/PDF()/Render()/GetBoundingBoxes()/etc. to scan
+// multiple regions of the same image with one Client, since each of them binds
+// the current rectangle to the API after binding the image.
+func (c *Client) SetRectangle(x, y, w, h int) *Client {
+	c.rect = &rectangle{X: x, Y: y, W: w, H: h}
+	return c
+}
+
 // SetConfigFile sets the file path to config file.
 func (c *Client) SetConfigFile(fpath string) error {
 	info, err := os.Stat(fpath)
@@ -142,14 +217,24 @@ func (c *Client) charConfig() *C.char {
 	return config
 }
 
+// It's due to the caller to free this char pointer.
+func (c *Client) charTessdataPrefix() *C.char {
+	prefix := c.tessdataPrefix()
+	if prefix == "" {
+		return nil
+	}
+	return C.CString(prefix)
+}
+
 // Initialize tesseract::TessBaseAPI
-// TODO: add tessdata prefix
 func (c *Client) init() error {
 	langs := c.charLangs()
 	defer C.free(unsafe.Pointer(langs))
 	config := c.charConfig()
 	defer C.free(unsafe.Pointer(config))
-	res := C.Init(c.api, nil, langs, config)
+	datapath := c.charTessdataPrefix()
+	defer C.free(unsafe.Pointer(datapath))
+	res := C.Init(c.api, datapath, langs, config)
 	if res != 0 {
 		// TODO: capture and vacuum stderr from Cgo
 		return fmt.Errorf("failed to initialize TessBaseAPI with code %d", res)
@@ -160,11 +245,17 @@ func (c *Client) init() error {
 // Prepare tesseract::TessBaseAPI options,
 // must be called after `init`.
 func (c *Client) prepare() error {
-	// Set Image by giving path
-	imagepath := C.CString(c.ImagePath)
-	defer C.free(unsafe.Pointer(imagepath))
-	C.SetImage(c.api, imagepath)
+	if err := c.setImage(); err != nil {
+		return err
+	}
+	return c.prepareOptions()
+}
 
+// prepareOptions binds Variables, PageSegMode and Rectangle to the API,
+// independently of image state. Entry points that drive the API over image
+// paths they're given directly, such as ProcessPages, call this instead of
+// `prepare` so they still honor Variables and PageSegMode. Must be called after `init`.
+func (c *Client) prepareOptions() error {
 	for key, value := range c.Variables {
 		if ok := c.bind(key, value); !ok {
 			return fmt.Errorf("failed to set variable with key(%s):value(%s)", key, value)
@@ -175,6 +266,33 @@ func (c *Client) prepare() error {
 		mode := C.int(*c.PageSegMode)
 		C.SetPageSegMode(c.api, mode)
 	}
+
+	if c.rect != nil {
+		C.SetRectangle(c.api, C.int(c.rect.X), C.int(c.rect.Y), C.int(c.rect.W), C.int(c.rect.H))
+	}
+	return nil
+}
+
+// setImage binds whichever image source was configured (a Pix, in-memory bytes,
+// or a file path) to the underlying TessBaseAPI. Must be called from inside `prepare`.
+func (c *Client) setImage() error {
+	if c.pix == nil && len(c.imageBytes) != 0 {
+		pix := C.PixReadMem((*C.uchar)(unsafe.Pointer(&c.imageBytes[0])), C.size_t(len(c.imageBytes)))
+		if pix == nil {
+			return fmt.Errorf("gosseract: failed to decode image data")
+		}
+		c.pix = pix
+		c.ownsPix = true
+	}
+
+	if c.pix != nil {
+		C.SetPix(c.api, c.pix)
+		return nil
+	}
+
+	imagepath := C.CString(c.ImagePath)
+	defer C.free(unsafe.Pointer(imagepath))
+	C.SetImage(c.api, imagepath)
 	return nil
 }
 
@@ -215,3 +333,37 @@ func (c *Client) HTML() (out string, err error) {
 	out = C.GoString(C.HOCRText(c.api))
 	return
 }
+
+// PDF finally initialize tesseract::TessBaseAPI, execute OCR and writes a searchable PDF
+// to "<outputBase>.pdf", overlaying an invisible text layer on top of the source image.
+// If textOnly is true, the source image is omitted and only the text layer is written.
+// Unlike ProcessMultiPage, this renders whichever image is currently bound to the
+// Client - via SetImage, SetImageFromBytes/Reader or SetPix - so it also honors
+// SetRectangle, and works without a file on disk.
+func (c *Client) PDF(outputBase string, textOnly bool) (err error) {
+	if err = c.init(); err != nil {
+		return
+	}
+	if err = c.prepare(); err != nil {
+		return
+	}
+
+	outputbase := C.CString(outputBase)
+	defer C.free(unsafe.Pointer(outputbase))
+	datapath := c.charTessdataPrefix()
+	defer C.free(unsafe.Pointer(datapath))
+
+	if ok := bool(C.PDF(c.api, outputbase, datapath, C.bool(textOnly))); !ok {
+		return fmt.Errorf("gosseract: failed to render PDF to %s.pdf", outputBase)
+	}
+	return nil
+}
+
+// tessdataPrefix returns the directory tesseract should use to locate
+// traineddata and renderer assets like pdf.ttf.
+func (c *Client) tessdataPrefix() string {
+	if c.TessdataPrefix != nil {
+		return *c.TessdataPrefix
+	}
+	return os.Getenv("TESSDATA_PREFIX")
+}
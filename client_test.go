@@ -0,0 +1,31 @@
+package gosseract
+
+import "testing"
+
+// TestSetImageClearsPriorImageState guards against the setImage precedence
+// bug fixed alongside ProcessPagesFunc: SetPix/SetImageFromBytes leave c.pix
+// resp. c.imageBytes set, and setImage() prefers c.pix over c.imageBytes over
+// c.ImagePath, so any caller that reuses a Client across images by only
+// reassigning c.ImagePath (as ProcessPagesFunc's loop used to) keeps
+// re-OCRing the stale pix/bytes forever. SetImage must clear both.
+func TestSetImageClearsPriorImageState(t *testing.T) {
+	c := &Client{}
+
+	if err := c.SetImageFromBytes([]byte{0x89, 0x50, 0x4e, 0x47}); err != nil {
+		t.Fatalf("SetImageFromBytes() returned error: %v", err)
+	}
+	if len(c.imageBytes) == 0 {
+		t.Fatal("expected imageBytes to be set after SetImageFromBytes")
+	}
+
+	c.SetImage("page2.png")
+	if c.imageBytes != nil {
+		t.Fatal("SetImage() did not clear imageBytes from a prior SetImageFromBytes call")
+	}
+	if c.pix != nil {
+		t.Fatal("SetImage() did not clear pix from a prior SetImageFromBytes call")
+	}
+	if c.ImagePath != "page2.png" {
+		t.Fatalf("ImagePath = %q, want %q", c.ImagePath, "page2.png")
+	}
+}
@@ -0,0 +1,71 @@
+// Package embed lets applications ship traineddata (and optionally prebuilt
+// tesseract/leptonica shared libraries) inside their Go binary via go:embed,
+// instead of requiring a system tesseract install on the target machine.
+package embed
+
+import (
+	"embed"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Register extracts subdir of fsys into a new temporary directory and points
+// TESSDATA_PREFIX at it, so a subsequent gosseract.NewClient finds traineddata
+// without a system tessdata install. The returned cleanup function restores
+// the previous TESSDATA_PREFIX and removes the temporary directory; callers
+// must invoke it, typically via defer, once the Client is no longer needed.
+//
+// Register mutates the process-wide TESSDATA_PREFIX environment variable, so
+// it is not safe to call concurrently from multiple goroutines: two
+// in-flight Register/cleanup pairs can stomp each other's TESSDATA_PREFIX.
+// Callers needing several embedded bundles at once should set TessdataPrefix
+// on each Client explicitly instead of relying on the environment variable.
+func Register(fsys embed.FS, subdir string) (cleanup func(), err error) {
+	dir, err := os.MkdirTemp("", "gosseract-embed")
+	if err != nil {
+		return nil, err
+	}
+	if err := extract(fsys, subdir, dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	previous, hadPrevious := os.LookupEnv("TESSDATA_PREFIX")
+	if err := os.Setenv("TESSDATA_PREFIX", dir); err != nil {
+		os.RemoveAll(dir)
+		return nil, err
+	}
+
+	return func() {
+		if hadPrevious {
+			os.Setenv("TESSDATA_PREFIX", previous)
+		} else {
+			os.Unsetenv("TESSDATA_PREFIX")
+		}
+		os.RemoveAll(dir)
+	}, nil
+}
+
+// extract copies every file under subdir in fsys into dir, preserving its
+// relative layout.
+func extract(fsys embed.FS, subdir, dir string) error {
+	return fs.WalkDir(fsys, subdir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(subdir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := fsys.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	})
+}
@@ -0,0 +1,62 @@
+package embed
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+//go:embed testdata/tessdata
+var testdataFS embed.FS
+
+func TestRegisterExtractsAndSetsTessdataPrefix(t *testing.T) {
+	os.Unsetenv("TESSDATA_PREFIX")
+
+	cleanup, err := Register(testdataFS, "testdata/tessdata")
+	if err != nil {
+		t.Fatalf("Register() returned error: %v", err)
+	}
+
+	dir := os.Getenv("TESSDATA_PREFIX")
+	if dir == "" {
+		t.Fatal("Register() did not set TESSDATA_PREFIX")
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "eng.traineddata"))
+	if err != nil {
+		t.Fatalf("expected eng.traineddata to be extracted: %v", err)
+	}
+	if len(data) == 0 {
+		t.Fatal("extracted eng.traineddata is empty")
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "configs", "hocr.config")); err != nil {
+		t.Fatalf("expected nested configs/hocr.config to be extracted: %v", err)
+	}
+
+	cleanup()
+
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Fatalf("expected temp dir to be removed after cleanup, stat err = %v", err)
+	}
+	if got := os.Getenv("TESSDATA_PREFIX"); got != "" {
+		t.Fatalf("expected TESSDATA_PREFIX to be unset after cleanup, got %q", got)
+	}
+}
+
+func TestRegisterRestoresPreviousTessdataPrefix(t *testing.T) {
+	const previous = "/usr/local/share/tessdata"
+	os.Setenv("TESSDATA_PREFIX", previous)
+	defer os.Unsetenv("TESSDATA_PREFIX")
+
+	cleanup, err := Register(testdataFS, "testdata/tessdata")
+	if err != nil {
+		t.Fatalf("Register() returned error: %v", err)
+	}
+	cleanup()
+
+	if got := os.Getenv("TESSDATA_PREFIX"); got != previous {
+		t.Fatalf("expected TESSDATA_PREFIX to be restored to %q, got %q", previous, got)
+	}
+}
@@ -0,0 +1,24 @@
+package gosseract
+
+import (
+	stdembed "embed"
+	"os"
+
+	"github.com/PierreKircher/gosseract/embed"
+)
+
+// NewClientWithEmbedded behaves like NewClient, but first unpacks the
+// traineddata bundled at subdir of fsys via embed.Register and points the
+// returned Client's TessdataPrefix at it. The caller must invoke the returned
+// cleanup function, typically via defer, after calling Close on the Client.
+func NewClientWithEmbedded(fsys stdembed.FS, subdir string) (client *Client, cleanup func(), err error) {
+	cleanup, err = embed.Register(fsys, subdir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	prefix := os.Getenv("TESSDATA_PREFIX")
+	client = NewClient()
+	client.TessdataPrefix = &prefix
+	return client, cleanup, nil
+}
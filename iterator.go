@@ -0,0 +1,76 @@
+package gosseract
+
+// #include <stdlib.h>
+// #include "tessbridge.h"
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// PageIteratorLevel represents tesseract::PageIteratorLevel, the granularity at
+// which Client.GetBoundingBoxes walks recognized results.
+type PageIteratorLevel int
+
+const (
+	RIL_BLOCK PageIteratorLevel = iota
+	RIL_PARA
+	RIL_TEXTLINE
+	RIL_WORD
+	RIL_SYMBOL
+)
+
+// BoundingBox is one element (block, paragraph, line, word or symbol, depending
+// on the requested PageIteratorLevel) recognized in the image, along with its
+// text, confidence and position.
+type BoundingBox struct {
+	Word       string
+	Confidence float64
+	X1, Y1     int
+	X2, Y2     int
+}
+
+// GetBoundingBoxes finally initializes tesseract::TessBaseAPI, executes OCR and
+// walks the resulting ResultIterator at the given level, returning the text,
+// confidence and bounding box of every element found.
+func (c *Client) GetBoundingBoxes(level PageIteratorLevel) ([]BoundingBox, error) {
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+	if err := c.prepare(); err != nil {
+		return nil, err
+	}
+	if res := C.Recognize(c.api); res != 0 {
+		return nil, fmt.Errorf("gosseract: failed to recognize image with code %d", int(res))
+	}
+
+	it := C.GetIterator(c.api)
+	if it == nil {
+		return nil, fmt.Errorf("gosseract: failed to obtain result iterator")
+	}
+	defer C.FreeIterator(it)
+
+	boxes := []BoundingBox{}
+	clevel := C.int(level)
+	for {
+		text := C.IteratorText(it, clevel)
+		if text != nil {
+			var x1, y1, x2, y2 C.int
+			C.IteratorBoundingBox(it, clevel, &x1, &y1, &x2, &y2)
+			boxes = append(boxes, BoundingBox{
+				Word:       C.GoString(text),
+				Confidence: float64(C.IteratorConfidence(it, clevel)),
+				X1:         int(x1),
+				Y1:         int(y1),
+				X2:         int(x2),
+				Y2:         int(y2),
+			})
+			C.free(unsafe.Pointer(text))
+		}
+
+		if !bool(C.IteratorNext(it, clevel)) {
+			break
+		}
+	}
+	return boxes, nil
+}
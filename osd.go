@@ -0,0 +1,59 @@
+package gosseract
+
+// #include <stdlib.h>
+// #include "tessbridge.h"
+import "C"
+import (
+	"fmt"
+	"unsafe"
+)
+
+// OSDResult holds the result of Orientation and Script Detection (OSD), as
+// returned by Client.DetectOrientation.
+type OSDResult struct {
+	// DegreesClockwise is the detected clockwise rotation of the page, in degrees.
+	DegreesClockwise int
+
+	// OrientationConfidence is how confident Tesseract is in DegreesClockwise.
+	OrientationConfidence float64
+
+	// ScriptName is the name of the detected script, e.g. "Latin" or "Han".
+	ScriptName string
+
+	// ScriptConfidence is how confident Tesseract is in ScriptName.
+	ScriptConfidence float64
+}
+
+// DetectOrientation runs Tesseract's Orientation and Script Detection (OSD, `--psm 0`)
+// on the configured image and returns page rotation, orientation confidence, detected
+// script and script confidence, without running full OCR. The "osd" traineddata is
+// appended to Languages automatically for the duration of this call.
+func (c *Client) DetectOrientation() (*OSDResult, error) {
+	languages := c.Languages
+	c.Languages = append(append([]string{}, languages...), "osd")
+	defer func() { c.Languages = languages }()
+
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+	if err := c.prepare(); err != nil {
+		return nil, err
+	}
+
+	var degrees C.int
+	var orientConf C.float
+	var scriptName *C.char
+	var scriptConf C.float
+
+	if ok := bool(C.DetectOrientationScript(c.api, &degrees, &orientConf, &scriptName, &scriptConf)); !ok {
+		return nil, fmt.Errorf("gosseract: failed to detect orientation and script")
+	}
+	defer C.free(unsafe.Pointer(scriptName))
+
+	return &OSDResult{
+		DegreesClockwise:      int(degrees),
+		OrientationConfidence: float64(orientConf),
+		ScriptName:            C.GoString(scriptName),
+		ScriptConfidence:      float64(scriptConf),
+	}, nil
+}
@@ -0,0 +1,135 @@
+package gosseract
+
+// #include <stdlib.h>
+// #include "tessbridge.h"
+import "C"
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unsafe"
+)
+
+// ProcessPages OCRs each of paths, in order, and renders them into a single
+// consolidated document using format, calling tesseract::TessBaseAPI::ProcessPage
+// for each page with one shared renderer. It's meant for a batch of separate page
+// images; for a multi-page TIFF or PDF file, use ProcessMultiPage instead.
+// Variables and PageSegMode configured on the Client are applied to every page.
+// A rectangle set via SetRectangle is bound before any page image is, so it does
+// not restrict the pages: each page is OCR'd in full.
+func (c *Client) ProcessPages(paths []string, format RenderFormat) ([]byte, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("gosseract: no pages to process")
+	}
+	ext, err := format.extension()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+	if err := c.prepareOptions(); err != nil {
+		return nil, err
+	}
+
+	cPaths := make([]*C.char, len(paths))
+	for i, path := range paths {
+		cPaths[i] = C.CString(path)
+	}
+	defer func() {
+		for _, p := range cPaths {
+			C.free(unsafe.Pointer(p))
+		}
+	}()
+
+	dir, err := os.MkdirTemp("", "gosseract")
+	if err != nil {
+		return nil, fmt.Errorf("gosseract: failed to create temp dir for page output: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	outputbase := filepath.Join(dir, "out")
+
+	coutputbase := C.CString(outputbase)
+	defer C.free(unsafe.Pointer(coutputbase))
+	datapath := c.charTessdataPrefix()
+	defer C.free(unsafe.Pointer(datapath))
+
+	ok := bool(C.ProcessPagesList(c.api, (**C.char)(unsafe.Pointer(&cPaths[0])), C.int(len(cPaths)), coutputbase, datapath, C.int(format)))
+	if !ok {
+		return nil, fmt.Errorf("gosseract: failed to process pages")
+	}
+
+	return os.ReadFile(outputbase + ext)
+}
+
+// ProcessMultiPage OCRs every page of the multi-page TIFF or PDF at path and
+// renders it into a single consolidated document using format.
+func (c *Client) ProcessMultiPage(path string, format RenderFormat) ([]byte, error) {
+	ext, err := format.extension()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.init(); err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "gosseract")
+	if err != nil {
+		return nil, fmt.Errorf("gosseract: failed to create temp dir for page output: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	outputbase := filepath.Join(dir, "out")
+
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	coutputbase := C.CString(outputbase)
+	defer C.free(unsafe.Pointer(coutputbase))
+	datapath := c.charTessdataPrefix()
+	defer C.free(unsafe.Pointer(datapath))
+
+	if ok := bool(C.RenderMultiPage(c.api, cpath, coutputbase, datapath, C.int(format))); !ok {
+		return nil, fmt.Errorf("gosseract: failed to process multi-page file %s", path)
+	}
+
+	return os.ReadFile(outputbase + ext)
+}
+
+// ProcessPagesFunc OCRs each of paths, in order, re-using a single TessBaseAPI
+// across all of them (initializing once, then looping SetImage+Recognize) and
+// invoking fn with the recognized text of each page. This avoids the
+// re-initialization that Text()/HTML() do on every call, which matters for
+// throughput when OCRing hundreds of pages.
+func (c *Client) ProcessPagesFunc(paths []string, fn func(page int, text string) error) error {
+	if len(paths) == 0 {
+		return fmt.Errorf("gosseract: no pages to process")
+	}
+	if err := c.init(); err != nil {
+		return err
+	}
+
+	previous := c.ImagePath
+	defer func() { c.ImagePath = previous }()
+
+	for i, path := range paths {
+		// SetImage clears any stale pix/imageBytes left over from SetPix/
+		// SetImageFromBytes/SetImageFromReader, so each page is actually
+		// re-read from its own path instead of re-OCRing a bound-over image.
+		c.SetImage(path)
+		if err := c.prepare(); err != nil {
+			return fmt.Errorf("gosseract: failed to prepare page %d: %w", i, err)
+		}
+		if res := C.Recognize(c.api); res != 0 {
+			return fmt.Errorf("gosseract: failed to recognize page %d with code %d", i, int(res))
+		}
+
+		text := C.GoString(C.UTF8Text(c.api))
+		if c.Trim {
+			text = strings.Trim(text, "\n")
+		}
+		if err := fn(i, text); err != nil {
+			return err
+		}
+	}
+	return nil
+}
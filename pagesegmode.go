@@ -0,0 +1,24 @@
+package gosseract
+
+// PageSegMode represents tesseract::PageSegMode, which controls how
+// Tesseract analyzes page layout before recognizing characters.
+// See https://github.com/tesseract-ocr/tesseract/blob/master/include/tesseract/publictypes.h
+type PageSegMode int
+
+const (
+	PSM_OSD_ONLY PageSegMode = iota
+	PSM_AUTO_OSD
+	PSM_AUTO_ONLY
+	PSM_AUTO
+	PSM_SINGLE_COLUMN
+	PSM_SINGLE_BLOCK_VERT_TEXT
+	PSM_SINGLE_BLOCK
+	PSM_SINGLE_LINE
+	PSM_SINGLE_WORD
+	PSM_CIRCLE_WORD
+	PSM_SINGLE_CHAR
+	PSM_SPARSE_TEXT
+	PSM_SPARSE_TEXT_OSD
+	PSM_RAW_LINE
+	PSM_COUNT
+)
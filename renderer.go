@@ -0,0 +1,103 @@
+package gosseract
+
+// #include <stdlib.h>
+// #include "tessbridge.h"
+import "C"
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"unsafe"
+)
+
+// RenderFormat selects which Tesseract renderer Client.Render drives.
+type RenderFormat int
+
+const (
+	// RenderFormatALTO produces ALTO XML, as consumed by digital-library/archive pipelines.
+	RenderFormatALTO RenderFormat = iota
+	// RenderFormatTSV produces tab-separated layout data, one row per recognized element.
+	RenderFormatTSV
+	// RenderFormatBoxText produces Tesseract's legacy .box training format.
+	RenderFormatBoxText
+	// RenderFormatWordStrBox produces word-level .box training format.
+	RenderFormatWordStrBox
+	// RenderFormatText produces plain text, like Text() but via ProcessPages.
+	RenderFormatText
+	// RenderFormatHOCR produces hOCR, like HTML() but via ProcessPages.
+	RenderFormatHOCR
+	// RenderFormatPDF produces a searchable PDF, like PDF() but via ProcessPages.
+	RenderFormatPDF
+)
+
+// extension returns the file extension Tesseract's renderer appends to the output base.
+func (f RenderFormat) extension() (string, error) {
+	switch f {
+	case RenderFormatALTO:
+		return ".xml", nil
+	case RenderFormatTSV:
+		return ".tsv", nil
+	case RenderFormatBoxText, RenderFormatWordStrBox:
+		return ".box", nil
+	case RenderFormatText:
+		return ".txt", nil
+	case RenderFormatHOCR:
+		return ".hocr", nil
+	case RenderFormatPDF:
+		return ".pdf", nil
+	default:
+		return "", fmt.Errorf("gosseract: unknown render format %d", f)
+	}
+}
+
+// Render finally initializes tesseract::TessBaseAPI, executes OCR using the renderer
+// selected by format, and returns its serialized output. It renders whichever image
+// is currently bound to the Client - via SetImage, SetImageFromBytes/Reader or SetPix -
+// so it also honors SetRectangle, and works without a file on disk. For a multi-page
+// TIFF or PDF file, use ProcessMultiPage instead.
+func (c *Client) Render(format RenderFormat) (out string, err error) {
+	ext, err := format.extension()
+	if err != nil {
+		return "", err
+	}
+	if err = c.init(); err != nil {
+		return
+	}
+	if err = c.prepare(); err != nil {
+		return
+	}
+
+	dir, err := os.MkdirTemp("", "gosseract")
+	if err != nil {
+		return "", fmt.Errorf("gosseract: failed to create temp dir for renderer output: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	outputbase := filepath.Join(dir, "out")
+
+	coutputbase := C.CString(outputbase)
+	defer C.free(unsafe.Pointer(coutputbase))
+	datapath := c.charTessdataPrefix()
+	defer C.free(unsafe.Pointer(datapath))
+
+	if ok := bool(C.RenderCurrent(c.api, coutputbase, datapath, C.int(format))); !ok {
+		return "", fmt.Errorf("gosseract: failed to render %s output", ext)
+	}
+
+	data, err := os.ReadFile(outputbase + ext)
+	if err != nil {
+		return "", fmt.Errorf("gosseract: failed to read rendered output: %w", err)
+	}
+	return string(data), nil
+}
+
+// ALTO renders ALTO XML output.
+func (c *Client) ALTO() (string, error) { return c.Render(RenderFormatALTO) }
+
+// TSV renders tab-separated layout output.
+func (c *Client) TSV() (string, error) { return c.Render(RenderFormatTSV) }
+
+// BoxText renders Tesseract's legacy .box training format.
+func (c *Client) BoxText() (string, error) { return c.Render(RenderFormatBoxText) }
+
+// WordStrBox renders word-level .box training format.
+func (c *Client) WordStrBox() (string, error) { return c.Render(RenderFormatWordStrBox) }